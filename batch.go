@@ -0,0 +1,52 @@
+package traceLib
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+/*
+batchOptionsFromEnv builds the sdktrace.BatchSpanProcessorOption defaults InitTracerProvider
+applies before any WithBatchOptions, from OTEL_BSP_SCHEDULE_DELAY, OTEL_BSP_EXPORT_TIMEOUT,
+OTEL_BSP_MAX_QUEUE_SIZE and OTEL_BSP_MAX_EXPORT_BATCH_SIZE (all in milliseconds where applicable).
+Unset or invalid values are left to the SDK's own defaults.
+*/
+func batchOptionsFromEnv() []sdktrace.BatchSpanProcessorOption {
+	var opts []sdktrace.BatchSpanProcessorOption
+	if d, ok := envDuration("OTEL_BSP_SCHEDULE_DELAY"); ok {
+		opts = append(opts, sdktrace.WithBatchTimeout(d))
+	}
+	if d, ok := envDuration("OTEL_BSP_EXPORT_TIMEOUT"); ok {
+		opts = append(opts, sdktrace.WithExportTimeout(d))
+	}
+	if n, ok := envInt("OTEL_BSP_MAX_QUEUE_SIZE"); ok {
+		opts = append(opts, sdktrace.WithMaxQueueSize(n))
+	}
+	if n, ok := envInt("OTEL_BSP_MAX_EXPORT_BATCH_SIZE"); ok {
+		opts = append(opts, sdktrace.WithMaxExportBatchSize(n))
+	}
+	return opts
+}
+
+func envDuration(key string) (time.Duration, bool) {
+	ms, ok := envInt(key)
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(ms) * time.Millisecond, true
+}
+
+func envInt(key string) (int, bool) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,109 @@
+package traceLib
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"os"
+)
+
+/*
+newExporter picks and initializes a sdktrace.SpanExporter based on the standard OTEL_TRACES_EXPORTER
+env var ("otlp", "jaeger", "stdout" or "none"). When OTEL_TRACES_EXPORTER is unset but JAEGER_ADDRESS
+is set, it falls back to the legacy Jaeger exporter for backwards compatibility.
+*/
+func newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	switch strings.ToLower(os.Getenv("OTEL_TRACES_EXPORTER")) {
+	case "otlp":
+		return newOTLPExporter(ctx)
+	case "jaeger":
+		return newJaegerExporter()
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "none":
+		return newNoopExporter(), nil
+	case "":
+		if os.Getenv("JAEGER_ADDRESS") != "" {
+			return newJaegerExporter()
+		}
+		return nil, errors.New("couldn't read .env variables for JAEGER_ADDRESS. Please check if you provided it correctly")
+	default:
+		return nil, errors.New("unsupported OTEL_TRACES_EXPORTER value, expected one of otlp, jaeger, stdout, none")
+	}
+}
+
+/*
+newJaegerExporter initializes jaeger.Exporter and returns it. It also returns error if JAEGER_ADDRESS not found or jaeger cant init exporter
+*/
+func newJaegerExporter() (*jaeger.Exporter, error) {
+	addr := os.Getenv("JAEGER_ADDRESS")
+	if addr == "" {
+		return nil, errors.New("couldn't read .env variables for JAEGER_ADDRESS. Please check if you provided it correctly")
+	}
+	exp, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(addr)))
+	if err != nil {
+		return nil, err
+	}
+	return exp, nil
+}
+
+/*
+newOTLPExporter builds an OTLP exporter from OTEL_EXPORTER_OTLP_ENDPOINT, OTEL_EXPORTER_OTLP_PROTOCOL
+("grpc" or "http/protobuf", defaulting to http/protobuf per the OTel spec) and
+OTEL_EXPORTER_OTLP_HEADERS (comma separated key=value pairs).
+*/
+func newOTLPExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	headers := parseOTLPHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"))
+
+	switch strings.ToLower(os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")) {
+	case "grpc":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithHeaders(headers)}
+		if endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(endpoint))
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	default:
+		opts := []otlptracehttp.Option{otlptracehttp.WithHeaders(headers)}
+		if endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+		}
+		return otlptracehttp.New(ctx, opts...)
+	}
+}
+
+func parseOTLPHeaders(raw string) map[string]string {
+	headers := map[string]string{}
+	if raw == "" {
+		return headers
+	}
+	for _, pair := range strings.Split(raw, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		headers[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return headers
+}
+
+// noopExporter discards every span it receives. It backs the "none" OTEL_TRACES_EXPORTER value.
+type noopExporter struct{}
+
+func newNoopExporter() *noopExporter {
+	return &noopExporter{}
+}
+
+func (n *noopExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	return nil
+}
+
+func (n *noopExporter) Shutdown(context.Context) error {
+	return nil
+}
@@ -0,0 +1,30 @@
+package traceLib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseOTLPHeaders(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want map[string]string
+	}{
+		{name: "empty", raw: "", want: map[string]string{}},
+		{name: "single pair", raw: "api-key=secret", want: map[string]string{"api-key": "secret"}},
+		{name: "multiple pairs", raw: "a=1,b=2", want: map[string]string{"a": "1", "b": "2"}},
+		{name: "trims surrounding whitespace", raw: " a = 1 , b = 2 ", want: map[string]string{"a": "1", "b": "2"}},
+		{name: "value containing an equals sign", raw: "a=b=c", want: map[string]string{"a": "b=c"}},
+		{name: "malformed entry without '=' is skipped", raw: "a=1,malformed,b=2", want: map[string]string{"a": "1", "b": "2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseOTLPHeaders(tt.raw)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseOTLPHeaders(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Package gin adapts traceLib.ServerMiddleware to gin's handler signature, since *gin.Context
+// isn't an http.Handler and can't go through the net/http middleware chain directly.
+package gin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/OSSIT-Tim1/traceLib"
+	"github.com/gin-gonic/gin"
+)
+
+type routeCtxKeyType struct{}
+
+var routeCtxKey routeCtxKeyType
+
+/*
+Middleware returns a gin.HandlerFunc that applies traceLib.ServerMiddleware around the rest of the
+gin chain, using the matched route's gin.Context.FullPath() as the http.route attribute.
+*/
+func Middleware(serviceName string, opts ...traceLib.MiddlewareOption) gin.HandlerFunc {
+	routeOpt := traceLib.WithRouteFunc(func(r *http.Request) string {
+		route, _ := r.Context().Value(routeCtxKey).(string)
+		return route
+	})
+	wrapped := traceLib.ServerMiddleware(serviceName, append([]traceLib.MiddlewareOption{routeOpt}, opts...)...)
+
+	return func(c *gin.Context) {
+		ctx := context.WithValue(c.Request.Context(), routeCtxKey, c.FullPath())
+		c.Request = c.Request.WithContext(ctx)
+
+		handler := wrapped(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c.Writer = &ginResponseWriterAdapter{ResponseWriter: c.Writer, rec: w}
+			c.Request = r
+			c.Next()
+		}))
+		handler.ServeHTTP(c.Writer, c.Request)
+	}
+}
+
+// ginResponseWriterAdapter routes Write/WriteHeader through rec (the traceLib statusRecorder, so
+// status code and bytes written are captured) while still satisfying gin.ResponseWriter by
+// delegating everything else to the original writer it wraps.
+type ginResponseWriterAdapter struct {
+	gin.ResponseWriter
+	rec http.ResponseWriter
+}
+
+func (a *ginResponseWriterAdapter) Write(b []byte) (int, error) {
+	return a.rec.Write(b)
+}
+
+func (a *ginResponseWriterAdapter) WriteHeader(code int) {
+	a.rec.WriteHeader(code)
+}
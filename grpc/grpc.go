@@ -0,0 +1,40 @@
+// Package grpc wires up traceLib's tracer provider and propagator for gRPC servers and clients,
+// mirroring the instrumentation traceLib.ExtractTraceInfoMiddleware provides for HTTP servers.
+package grpc
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+/*
+UnaryServerInterceptor returns a grpc.UnaryServerInterceptor that extracts the W3C TraceContext
+propagated by the caller and starts a SERVER span for the handled RPC.
+*/
+func UnaryServerInterceptor(opts ...otelgrpc.Option) grpc.UnaryServerInterceptor {
+	return otelgrpc.UnaryServerInterceptor(opts...)
+}
+
+/*
+StreamServerInterceptor returns a grpc.StreamServerInterceptor that extracts the W3C TraceContext
+propagated by the caller and starts a SERVER span for the handled RPC.
+*/
+func StreamServerInterceptor(opts ...otelgrpc.Option) grpc.StreamServerInterceptor {
+	return otelgrpc.StreamServerInterceptor(opts...)
+}
+
+/*
+UnaryClientInterceptor returns a grpc.UnaryClientInterceptor that starts a CLIENT span for the
+outgoing RPC and injects the current trace context into its metadata.
+*/
+func UnaryClientInterceptor(opts ...otelgrpc.Option) grpc.UnaryClientInterceptor {
+	return otelgrpc.UnaryClientInterceptor(opts...)
+}
+
+/*
+StreamClientInterceptor returns a grpc.StreamClientInterceptor that starts a CLIENT span for the
+outgoing RPC and injects the current trace context into its metadata.
+*/
+func StreamClientInterceptor(opts ...otelgrpc.Option) grpc.StreamClientInterceptor {
+	return otelgrpc.StreamClientInterceptor(opts...)
+}
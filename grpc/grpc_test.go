@@ -0,0 +1,18 @@
+package grpc
+
+import "testing"
+
+func TestInterceptorsAreConstructed(t *testing.T) {
+	if UnaryServerInterceptor() == nil {
+		t.Error("UnaryServerInterceptor() returned nil")
+	}
+	if StreamServerInterceptor() == nil {
+		t.Error("StreamServerInterceptor() returned nil")
+	}
+	if UnaryClientInterceptor() == nil {
+		t.Error("UnaryClientInterceptor() returned nil")
+	}
+	if StreamClientInterceptor() == nil {
+		t.Error("StreamClientInterceptor() returned nil")
+	}
+}
@@ -0,0 +1,181 @@
+package traceLib
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// MiddlewareOption configures ServerMiddleware.
+type MiddlewareOption func(*middlewareConfig)
+
+type middlewareConfig struct {
+	routeFunc   func(*http.Request) string
+	baggageKeys []string
+}
+
+/*
+WithBaggageAttributes copies the named baggage members, if present on the extracted context, onto
+the request's span as attributes (e.g. tenant or request metadata propagated via Baggage).
+*/
+func WithBaggageAttributes(keys ...string) MiddlewareOption {
+	return func(c *middlewareConfig) {
+		c.baggageKeys = append(c.baggageKeys, keys...)
+	}
+}
+
+/*
+WithRouteFunc overrides how ServerMiddleware derives the http.route attribute and span name for a
+request. By default it recognizes gorilla/mux and chi routes and falls back to r.URL.Path.
+*/
+func WithRouteFunc(routeFunc func(*http.Request) string) MiddlewareOption {
+	return func(c *middlewareConfig) {
+		c.routeFunc = routeFunc
+	}
+}
+
+/*
+ServerMiddleware wraps an http.Handler so that, after extracting any incoming trace context, it
+starts a SERVER span named after the matched route, records standard HTTP semconv attributes plus
+the final status code, captures panics as span errors, and writes a traceresponse header so
+clients can correlate the response with the server span. ExtractTraceInfoMiddleware remains a thin
+shim over the extraction step alone for callers who don't need the rest.
+*/
+func ServerMiddleware(serviceName string, opts ...MiddlewareOption) func(http.Handler) http.Handler {
+	cfg := &middlewareConfig{routeFunc: defaultRouteFunc}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	tracer := otel.Tracer(serviceName)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			route := cfg.routeFunc(r)
+			spanName := route
+			if spanName == "" {
+				spanName = r.Method
+			} else {
+				spanName = r.Method + " " + spanName
+			}
+
+			ctx, span := tracer.Start(ctx, spanName, oteltrace.WithSpanKind(oteltrace.SpanKindServer), oteltrace.WithAttributes(
+				semconv.HTTPMethodKey.String(r.Method),
+				semconv.HTTPTargetKey.String(r.URL.Path),
+				semconv.NetPeerIPKey.String(clientIP(r)),
+				semconv.HTTPUserAgentKey.String(r.UserAgent()),
+			))
+			defer span.End()
+			if route != "" {
+				span.SetAttributes(semconv.HTTPRouteKey.String(route))
+			}
+			if len(cfg.baggageKeys) > 0 {
+				bag := baggage.FromContext(ctx)
+				for _, key := range cfg.baggageKeys {
+					if member := bag.Member(key); member.Key() != "" {
+						span.SetAttributes(attribute.String(member.Key(), member.Value()))
+					}
+				}
+			}
+
+			otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(w.Header()))
+			w.Header().Set("traceresponse", traceResponseHeader(span.SpanContext()))
+
+			rec := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+
+			defer func() {
+				if rerr := recover(); rerr != nil {
+					span.RecordError(fmt.Errorf("panic: %v", rerr))
+					span.SetStatus(codes.Error, "panic recovered")
+					span.SetAttributes(semconv.HTTPStatusCodeKey.Int(http.StatusInternalServerError))
+					panic(rerr)
+				}
+			}()
+
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			span.SetAttributes(
+				semconv.HTTPStatusCodeKey.Int(rec.statusCode),
+				semconv.HTTPResponseContentLengthKey.Int64(rec.bytesWritten),
+			)
+			if rec.statusCode >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(rec.statusCode))
+			}
+		})
+	}
+}
+
+/*
+ExtractTraceInfoMiddleware is middleman function.
+This middleware is intended to be used with an HTTP server and will extract trace information from the incoming request and attach it to the request's context.
+This trace information can then be used downstream by other parts of the code to do things like log tracing information for requests.
+
+Prefer ServerMiddleware for new code; this is kept for backwards compatibility with callers that
+only want extraction without a server span.
+*/
+func ExtractTraceInfoMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// defaultRouteFunc recognizes gorilla/mux and chi routes and falls back to the request path.
+func defaultRouteFunc(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tmpl, err := route.GetPathTemplate(); err == nil {
+			return tmpl
+		}
+	}
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if pattern := rctx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	return r.URL.Path
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func traceResponseHeader(sc oteltrace.SpanContext) string {
+	flags := "00"
+	if sc.IsSampled() {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID(), sc.SpanID(), flags)
+}
+
+// statusRecorder wraps an http.ResponseWriter to capture the status code and byte count written to it.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode   int
+	bytesWritten int64
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.bytesWritten += int64(n)
+	return n, err
+}
@@ -0,0 +1,72 @@
+package traceLib
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/mux"
+)
+
+func TestDefaultRouteFunc(t *testing.T) {
+	t.Run("falls back to the request path with no router", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/widgets/42", nil)
+		if got := defaultRouteFunc(r); got != "/widgets/42" {
+			t.Errorf("defaultRouteFunc() = %q, want %q", got, "/widgets/42")
+		}
+	})
+
+	t.Run("resolves the gorilla/mux path template", func(t *testing.T) {
+		var got string
+		router := mux.NewRouter()
+		router.HandleFunc("/widgets/{id}", func(w http.ResponseWriter, r *http.Request) {
+			got = defaultRouteFunc(r)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/widgets/42", nil)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		if want := "/widgets/{id}"; got != want {
+			t.Errorf("defaultRouteFunc() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("resolves the chi route pattern", func(t *testing.T) {
+		var got string
+		router := chi.NewRouter()
+		router.Get("/widgets/{id}", func(w http.ResponseWriter, r *http.Request) {
+			got = defaultRouteFunc(r)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/widgets/42", nil)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		if want := "/widgets/{id}"; got != want {
+			t.Errorf("defaultRouteFunc() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		want       string
+	}{
+		{name: "host and port", remoteAddr: "203.0.113.5:54321", want: "203.0.113.5"},
+		{name: "ipv6 host and port", remoteAddr: "[2001:db8::1]:54321", want: "2001:db8::1"},
+		{name: "no port", remoteAddr: "203.0.113.5", want: "203.0.113.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+
+			if got := clientIP(r); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
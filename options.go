@@ -0,0 +1,73 @@
+package traceLib
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// config holds the settings InitTracerProvider assembles from Option values before
+// falling back to their OTEL_* env var equivalents.
+type config struct {
+	sampler            sdktrace.Sampler
+	resourceAttributes []attribute.KeyValue
+	serviceVersion     string
+	environment        string
+	propagators        []propagation.TextMapPropagator
+	batchOptions       []sdktrace.BatchSpanProcessorOption
+}
+
+// Option configures InitTracerProvider. Options take precedence over the env vars they mirror.
+type Option func(*config)
+
+// WithSampler sets the sdktrace.Sampler used for the tracer provider, overriding OTEL_TRACES_SAMPLER.
+func WithSampler(sampler sdktrace.Sampler) Option {
+	return func(c *config) {
+		c.sampler = sampler
+	}
+}
+
+// WithResourceAttributes adds extra attributes to the tracer provider's resource, in addition to
+// whatever OTEL_RESOURCE_ATTRIBUTES contributes.
+func WithResourceAttributes(attrs ...attribute.KeyValue) Option {
+	return func(c *config) {
+		c.resourceAttributes = append(c.resourceAttributes, attrs...)
+	}
+}
+
+// WithServiceVersion sets the service.version resource attribute.
+func WithServiceVersion(version string) Option {
+	return func(c *config) {
+		c.serviceVersion = version
+	}
+}
+
+// WithEnvironment sets the deployment.environment resource attribute.
+func WithEnvironment(environment string) Option {
+	return func(c *config) {
+		c.environment = environment
+	}
+}
+
+/*
+WithPropagators sets the propagators InitTracerProvider installs as the global TextMapPropagator,
+overriding OTEL_PROPAGATORS. Pass them individually; InitTracerProvider combines them into a single
+composite propagator.
+*/
+func WithPropagators(propagators ...propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = append(c.propagators, propagators...)
+	}
+}
+
+/*
+WithBatchOptions tunes the batch span processor InitTracerProvider installs (queue size, batch
+size, export timeout, schedule delay) in addition to the defaults derived from OTEL_BSP_* env
+vars. This matters once the exporter is OTLP over a slow network and the default batching
+behaviour no longer fits.
+*/
+func WithBatchOptions(opts ...sdktrace.BatchSpanProcessorOption) Option {
+	return func(c *config) {
+		c.batchOptions = append(c.batchOptions, opts...)
+	}
+}
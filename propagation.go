@@ -0,0 +1,70 @@
+package traceLib
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/contrib/propagators/jaeger"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+/*
+propagatorsFromEnv builds the propagation.TextMapPropagator InitTracerProvider installs when no
+WithPropagators option is given, by combining the propagators named in OTEL_PROPAGATORS
+("tracecontext", "baggage", "b3", "b3multi", "jaeger"). It defaults to TraceContext+Baggage when
+OTEL_PROPAGATORS is unset.
+*/
+func propagatorsFromEnv() propagation.TextMapPropagator {
+	raw := os.Getenv("OTEL_PROPAGATORS")
+	if raw == "" {
+		return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	}
+
+	var props []propagation.TextMapPropagator
+	for _, name := range strings.Split(raw, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "tracecontext":
+			props = append(props, propagation.TraceContext{})
+		case "baggage":
+			props = append(props, propagation.Baggage{})
+		case "b3":
+			props = append(props, b3.New(b3.WithInjectEncoding(b3.B3SingleHeader)))
+		case "b3multi":
+			props = append(props, b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)))
+		case "jaeger":
+			props = append(props, jaeger.Jaeger{})
+		}
+	}
+	if len(props) == 0 {
+		return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	}
+	return propagation.NewCompositeTextMapPropagator(props...)
+}
+
+/*
+BaggageFromRequest returns the baggage.Baggage carried by r's context, after it has gone through
+ExtractTraceInfoMiddleware or ServerMiddleware.
+*/
+func BaggageFromRequest(r *http.Request) baggage.Baggage {
+	return baggage.FromContext(r.Context())
+}
+
+/*
+WithBaggageValue returns a context derived from ctx with an additional baggage member key=value.
+*/
+func WithBaggageValue(ctx context.Context, key, value string) (context.Context, error) {
+	member, err := baggage.NewMember(key, value)
+	if err != nil {
+		return ctx, err
+	}
+	bag := baggage.FromContext(ctx)
+	bag, err = bag.SetMember(member)
+	if err != nil {
+		return ctx, err
+	}
+	return baggage.ContextWithBaggage(ctx, bag), nil
+}
@@ -0,0 +1,49 @@
+package traceLib
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestPropagatorsFromEnv(t *testing.T) {
+	tests := []struct {
+		name       string
+		envValue   string
+		wantFields []string
+	}{
+		{name: "unset defaults to tracecontext+baggage", wantFields: []string{"baggage", "traceparent", "tracestate"}},
+		{name: "tracecontext only", envValue: "tracecontext", wantFields: []string{"traceparent", "tracestate"}},
+		{name: "baggage only", envValue: "baggage", wantFields: []string{"baggage"}},
+		{name: "b3 single header", envValue: "b3", wantFields: []string{"b3"}},
+		{name: "b3multi", envValue: "b3multi", wantFields: []string{"x-b3-flags", "x-b3-sampled", "x-b3-spanid", "x-b3-traceid"}},
+		{name: "jaeger", envValue: "jaeger", wantFields: []string{"uber-trace-id"}},
+		{name: "combined list", envValue: "tracecontext,baggage", wantFields: []string{"baggage", "traceparent", "tracestate"}},
+		{name: "unrecognized entries are dropped, falls back to default when list ends up empty", envValue: "bogus", wantFields: []string{"baggage", "traceparent", "tracestate"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_PROPAGATORS", tt.envValue)
+
+			got := propagatorsFromEnv().Fields()
+			sort.Strings(got)
+			sort.Strings(tt.wantFields)
+
+			if !equalStrings(got, tt.wantFields) {
+				t.Errorf("propagatorsFromEnv().Fields() = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
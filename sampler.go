@@ -0,0 +1,37 @@
+package traceLib
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+/*
+samplerFromEnv builds a sdktrace.Sampler from OTEL_TRACES_SAMPLER / OTEL_TRACES_SAMPLER_ARG, per the
+OpenTelemetry spec. Supported values are "always_on", "always_off", "traceidratio" and
+"parentbased_traceidratio". It defaults to the spec default, ParentBased(AlwaysSample()), when unset.
+*/
+func samplerFromEnv() sdktrace.Sampler {
+	switch strings.ToLower(os.Getenv("OTEL_TRACES_SAMPLER")) {
+	case "always_on":
+		return sdktrace.AlwaysSample()
+	case "always_off":
+		return sdktrace.NeverSample()
+	case "traceidratio":
+		return sdktrace.TraceIDRatioBased(samplerRatioFromEnv())
+	case "parentbased_traceidratio":
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplerRatioFromEnv()))
+	default:
+		return sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+}
+
+func samplerRatioFromEnv() float64 {
+	ratio, err := strconv.ParseFloat(os.Getenv("OTEL_TRACES_SAMPLER_ARG"), 64)
+	if err != nil {
+		return 1.0
+	}
+	return ratio
+}
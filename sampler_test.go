@@ -0,0 +1,62 @@
+package traceLib
+
+import (
+	"testing"
+)
+
+// parentBasedDefaults is the part of a ParentBased sampler's description that lists its default
+// parent samplers, which follows the root sampler.
+const parentBasedDefaults = ",remoteParentSampled:AlwaysOnSampler,remoteParentNotSampled:AlwaysOffSampler," +
+	"localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler"
+
+func TestSamplerFromEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		sampler     string
+		samplerArg  string
+		wantSampler string
+	}{
+		{name: "unset defaults to parentbased always_on", wantSampler: "ParentBased{root:AlwaysOnSampler" + parentBasedDefaults + "}"},
+		{name: "always_on", sampler: "always_on", wantSampler: "AlwaysOnSampler"},
+		{name: "always_off", sampler: "always_off", wantSampler: "AlwaysOffSampler"},
+		{name: "traceidratio", sampler: "traceidratio", samplerArg: "0.5", wantSampler: "TraceIDRatioBased{0.5}"},
+		// A ratio of 1.0 makes sdktrace.TraceIDRatioBased return AlwaysSample().
+		{name: "traceidratio bad arg falls back to 1.0", sampler: "traceidratio", samplerArg: "not-a-float", wantSampler: "AlwaysOnSampler"},
+		{name: "parentbased_traceidratio", sampler: "parentbased_traceidratio", samplerArg: "0.25", wantSampler: "ParentBased{root:TraceIDRatioBased{0.25}" + parentBasedDefaults + "}"},
+		{name: "unrecognized value falls back to default", sampler: "bogus", wantSampler: "ParentBased{root:AlwaysOnSampler" + parentBasedDefaults + "}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_TRACES_SAMPLER", tt.sampler)
+			t.Setenv("OTEL_TRACES_SAMPLER_ARG", tt.samplerArg)
+
+			got := samplerFromEnv()
+			if got.Description() != tt.wantSampler {
+				t.Errorf("samplerFromEnv() = %q, want %q", got.Description(), tt.wantSampler)
+			}
+		})
+	}
+}
+
+func TestSamplerRatioFromEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want float64
+	}{
+		{name: "unset defaults to 1.0", arg: "", want: 1.0},
+		{name: "valid ratio", arg: "0.3", want: 0.3},
+		{name: "invalid ratio falls back to 1.0", arg: "not-a-float", want: 1.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_TRACES_SAMPLER_ARG", tt.arg)
+
+			if got := samplerRatioFromEnv(); got != tt.want {
+				t.Errorf("samplerRatioFromEnv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package traceLib
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var (
+	globalTracerProviderMu sync.Mutex
+	globalTracerProvider   *sdktrace.TracerProvider
+)
+
+/*
+Shutdown flushes and shuts down the tracer provider InitTracerProvider last installed. It force
+flushes any pending spans before shutting the exporter down, bounded by ctx's deadline if it has
+one, or by a deadline derived from OTEL_BSP_EXPORT_TIMEOUT/OTEL_BSP_SCHEDULE_DELAY otherwise. It is
+a no-op if InitTracerProvider hasn't been called.
+*/
+func Shutdown(ctx context.Context) error {
+	globalTracerProviderMu.Lock()
+	tp := globalTracerProvider
+	globalTracerProviderMu.Unlock()
+	if tp == nil {
+		return nil
+	}
+
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, shutdownTimeout())
+		defer cancel()
+	}
+
+	if err := tp.ForceFlush(ctx); err != nil {
+		return err
+	}
+	return tp.Shutdown(ctx)
+}
+
+/*
+RegisterShutdownOnSignal starts a goroutine that calls Shutdown when one of signals (os.Interrupt
+if none are given) is received, then closes the returned channel. It does not terminate the
+process: a tracing helper shouldn't own process lifecycle, since the host application may have its
+own listeners on the same signals (closing an http.Server, draining a DB pool, ...) that also need
+to run. Call it once after InitTracerProvider and, if the caller wants to exit once flushing is
+done, wait on the returned channel themselves, e.g.:
+
+	done := traceLib.RegisterShutdownOnSignal()
+	<-done
+	os.Exit(0)
+*/
+func RegisterShutdownOnSignal(signals ...os.Signal) <-chan struct{} {
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt}
+	}
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		<-ch
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout())
+		defer cancel()
+		_ = Shutdown(ctx)
+	}()
+	return done
+}
+
+// shutdownTimeout derives a flush deadline from OTEL_BSP_EXPORT_TIMEOUT and OTEL_BSP_SCHEDULE_DELAY,
+// falling back to the SDK's own defaults (30s export timeout, 5s schedule delay) when unset.
+func shutdownTimeout() time.Duration {
+	exportTimeout := 30 * time.Second
+	if d, ok := envDuration("OTEL_BSP_EXPORT_TIMEOUT"); ok {
+		exportTimeout = d
+	}
+	scheduleDelay := 5 * time.Second
+	if d, ok := envDuration("OTEL_BSP_SCHEDULE_DELAY"); ok {
+		scheduleDelay = d
+	}
+	return exportTimeout + scheduleDelay
+}
@@ -1,15 +1,13 @@
 package traceLib
 
 import (
-	"errors"
+	"context"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
-	"net/http"
-	"os"
 )
 
 /*
@@ -21,64 +19,84 @@ Here is how to set it up during your server init(don't forget to add ExtractTrac
 	if err != nil {
 		log.Println(err)
 	}
-	defer func() { _ = tp.Shutdown(context.Background()) }()
+	defer func() { _ = traceLib.Shutdown(context.Background()) }()
+	done := traceLib.RegisterShutdownOnSignal()
+	<-done // blocks here until a registered signal has been flushed and handled
+
+Pass Option values (WithSampler, WithResourceAttributes, WithServiceVersion, WithEnvironment,
+WithPropagators, WithBatchOptions) to override the OTEL_TRACES_SAMPLER / OTEL_RESOURCE_ATTRIBUTES /
+OTEL_PROPAGATORS / OTEL_BSP_* env vars they otherwise fall back to. By default the global
+propagator combines W3C TraceContext and Baggage.
 */
-func InitTracerProvider(serviceName string) (*sdktrace.TracerProvider, error) {
-	exp, err := newExporter()
+func InitTracerProvider(serviceName string, opts ...Option) (*sdktrace.TracerProvider, error) {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	ctx := context.Background()
+	exp, err := newExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tp, err := newTraceProvider(ctx, exp, serviceName, cfg)
 	if err != nil {
 		return nil, err
 	}
-	tp := newTraceProvider(exp, serviceName)
 	otel.SetTracerProvider(tp)
-	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	propagator := propagatorsFromEnv()
+	if len(cfg.propagators) > 0 {
+		propagator = propagation.NewCompositeTextMapPropagator(cfg.propagators...)
+	}
+	otel.SetTextMapPropagator(propagator)
+
+	globalTracerProviderMu.Lock()
+	globalTracerProvider = tp
+	globalTracerProviderMu.Unlock()
+
 	return tp, nil
 }
 
 /*
-newExporter initializes jaeger.Exporter and returns it. It also returns error if JAEGER_ADDRESS not found or eager cant init exporter
+newTraceProvider initializes sdktrace.TracerProvider and returns it, along with an error if the
+resource could not be assembled.
 */
-func newExporter() (*jaeger.Exporter, error) {
-	addr := os.Getenv("JAEGER_ADDRESS")
-	if addr == "" {
-		return nil, errors.New("couldn't read .env variables for JAEGER_ADDRESS. Please check if you provided it correctly")
+func newTraceProvider(ctx context.Context, exp sdktrace.SpanExporter, serviceName string, cfg *config) (*sdktrace.TracerProvider, error) {
+	attrs := append([]attribute.KeyValue{semconv.ServiceNameKey.String(serviceName)}, cfg.resourceAttributes...)
+	if cfg.serviceVersion != "" {
+		attrs = append(attrs, semconv.ServiceVersionKey.String(cfg.serviceVersion))
 	}
-	exp, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(addr)))
-	if err != nil {
-		return nil, err
+	if cfg.environment != "" {
+		attrs = append(attrs, semconv.DeploymentEnvironmentKey.String(cfg.environment))
 	}
-	return exp, nil
-}
 
-/*
-newExporter initializes sdktrace.TracerProvider and returns it.
-*/
-func newTraceProvider(exp sdktrace.SpanExporter, serviceName string) *sdktrace.TracerProvider {
-	r, err := resource.Merge(
-		resource.Default(),
-		resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceNameKey.String(serviceName),
-		),
+	r, err := resource.New(ctx,
+		resource.WithHost(),
+		resource.WithProcessPID(),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(attrs...),
 	)
-
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	r, err = resource.Merge(resource.Default(), r)
+	if err != nil {
+		return nil, err
+	}
+
+	sampler := cfg.sampler
+	if sampler == nil {
+		sampler = samplerFromEnv()
 	}
 
+	batchOpts := append(batchOptionsFromEnv(), cfg.batchOptions...)
+
 	return sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exp),
+		sdktrace.WithBatcher(exp, batchOpts...),
 		sdktrace.WithResource(r),
-	)
-}
-
-/*
-ExtractTraceInfoMiddleware is middleman function.
-This middleware is intended to be used with an HTTP server and will extract trace information from the incoming request and attach it to the request's context.
-This trace information can then be used downstream by other parts of the code to do things like log tracing information for requests.
-*/
-func ExtractTraceInfoMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+		sdktrace.WithSampler(sampler),
+	), nil
 }
@@ -0,0 +1,39 @@
+package traceLib
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+/*
+NewHTTPTransport wraps base (or http.DefaultTransport if base is nil) so that every outgoing request
+carries the current trace context, mirroring how ExtractTraceInfoMiddleware handles the inbound side.
+*/
+func NewHTTPTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &tracingTransport{base: base}
+}
+
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	InjectTraceInfo(req.Context(), req)
+	return t.base.RoundTrip(req)
+}
+
+/*
+InjectTraceInfo writes the trace information carried by ctx onto req's headers using the
+globally configured propagator, so the receiving service can pick it up via
+ExtractTraceInfoMiddleware.
+*/
+func InjectTraceInfo(ctx context.Context, req *http.Request) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+}
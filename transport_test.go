@@ -0,0 +1,102 @@
+package traceLib
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func sampledContext() context.Context {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	return trace.ContextWithSpanContext(context.Background(), sc)
+}
+
+func withTraceContextPropagator(t *testing.T) {
+	t.Helper()
+	prev := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(prev) })
+}
+
+type fakeRoundTripper struct {
+	req *http.Request
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.req = req
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestNewHTTPTransportInjectsTraceContext(t *testing.T) {
+	withTraceContextPropagator(t)
+
+	fake := &fakeRoundTripper{}
+	transport := NewHTTPTransport(fake)
+
+	req, err := http.NewRequestWithContext(sampledContext(), http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext() error = %v", err)
+	}
+
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	if got := fake.req.Header.Get("traceparent"); got == "" {
+		t.Error("expected traceparent header to be injected onto the outgoing request, got none")
+	}
+}
+
+func TestNewHTTPTransportDoesNotMutateOriginalRequest(t *testing.T) {
+	withTraceContextPropagator(t)
+
+	fake := &fakeRoundTripper{}
+	transport := NewHTTPTransport(fake)
+
+	req, err := http.NewRequestWithContext(sampledContext(), http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext() error = %v", err)
+	}
+
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	if got := req.Header.Get("traceparent"); got != "" {
+		t.Errorf("expected the caller's original request to be left untouched, got traceparent=%q", got)
+	}
+}
+
+func TestNewHTTPTransportDefaultsBase(t *testing.T) {
+	rt := NewHTTPTransport(nil)
+	tt, ok := rt.(*tracingTransport)
+	if !ok {
+		t.Fatalf("NewHTTPTransport(nil) returned %T, want *tracingTransport", rt)
+	}
+	if tt.base != http.DefaultTransport {
+		t.Error("NewHTTPTransport(nil) did not default base to http.DefaultTransport")
+	}
+}
+
+func TestInjectTraceInfo(t *testing.T) {
+	withTraceContextPropagator(t)
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	InjectTraceInfo(sampledContext(), req)
+
+	if got := req.Header.Get("traceparent"); got == "" {
+		t.Error("expected traceparent header to be injected, got none")
+	}
+}